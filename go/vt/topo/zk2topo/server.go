@@ -95,6 +95,9 @@ func (zs *Server) Close() {
 	zs.conn.Close()
 	zs.conn = nil
 }
+
+var _ topo.Conn = (*Server)(nil) // compile-time interface check
+
 func init() {
 	topo.RegisterFactory("zk2", Factory{})
 }